@@ -0,0 +1,159 @@
+package news_info
+
+import (
+	"errors"
+	"regexp"
+	"sync"
+)
+
+// Enricher enriches a CryptoNews item in place, e.g. by extracting
+// ticker symbols or tagging it by importance. Implementations should
+// be safe to run on any item more than once.
+type Enricher interface {
+	Enrich(news *CryptoNews) error
+}
+
+// Enrichers runs a sequence of Enricher implementations over a single
+// item. It itself implements Enricher, so chains can be nested.
+type Enrichers []Enricher
+
+func (chain Enrichers) Enrich(news *CryptoNews) error {
+	var errs []error
+	for _, e := range chain {
+		if err := e.Enrich(news); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// wordBoundaryPattern compiles phrase into a case-insensitive regex
+// matched on word boundaries, so e.g. "sec" doesn't match inside
+// "seconds" and "eth" doesn't match inside "method".
+func wordBoundaryPattern(phrase string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(phrase) + `\b`)
+}
+
+// SymbolEnricher populates CryptoNews.Symbols by matching a
+// configurable dictionary of ticker -> keyword aliases against a
+// news item's Content and ContentPrefix.
+type SymbolEnricher struct {
+	dictionary map[string][]*regexp.Regexp
+}
+
+func NewSymbolEnricher(dictionary map[string][]string) *SymbolEnricher {
+	compiled := make(map[string][]*regexp.Regexp, len(dictionary))
+	for symbol, aliases := range dictionary {
+		for _, alias := range aliases {
+			compiled[symbol] = append(compiled[symbol], wordBoundaryPattern(alias))
+		}
+	}
+	return &SymbolEnricher{dictionary: compiled}
+}
+
+// DefaultSymbolDictionary returns the built-in ticker -> alias mapping
+// used when no custom dictionary is supplied.
+func DefaultSymbolDictionary() map[string][]string {
+	return map[string][]string{
+		"BTC":  {"btc", "bitcoin"},
+		"ETH":  {"eth", "ethereum"},
+		"SOL":  {"sol", "solana"},
+		"BNB":  {"bnb", "binance coin"},
+		"XRP":  {"xrp", "ripple"},
+		"DOGE": {"doge", "dogecoin"},
+	}
+}
+
+func (s *SymbolEnricher) Enrich(news *CryptoNews) error {
+	haystack := news.ContentPrefix + " " + news.Content
+
+	for symbol, patterns := range s.dictionary {
+		for _, re := range patterns {
+			if re.MatchString(haystack) {
+				news.Symbols = appendUnique(news.Symbols, symbol)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type importancePattern struct {
+	re  *regexp.Regexp
+	tag string
+}
+
+// ImportanceEnricher tags CryptoNews.Tags from a configurable set of
+// keyword -> tag heuristics (e.g. "hack" -> "security").
+type ImportanceEnricher struct {
+	patterns []importancePattern
+}
+
+func NewImportanceEnricher(keywords map[string]string) *ImportanceEnricher {
+	patterns := make([]importancePattern, 0, len(keywords))
+	for keyword, tag := range keywords {
+		patterns = append(patterns, importancePattern{re: wordBoundaryPattern(keyword), tag: tag})
+	}
+	return &ImportanceEnricher{patterns: patterns}
+}
+
+// DefaultImportanceKeywords returns the built-in keyword -> tag
+// mapping used when no custom set is supplied.
+func DefaultImportanceKeywords() map[string]string {
+	return map[string]string{
+		"sec":          "regulatory",
+		"hack":         "security",
+		"exploit":      "security",
+		"listing":      "listing",
+		"delisting":    "listing",
+		"etf approved": "etf",
+		"etf":          "etf",
+	}
+}
+
+func (im *ImportanceEnricher) Enrich(news *CryptoNews) error {
+	haystack := news.ContentPrefix + " " + news.Content
+
+	for _, p := range im.patterns {
+		if p.re.MatchString(haystack) {
+			news.Tags = appendUnique(news.Tags, p.tag)
+		}
+	}
+	if len(news.Tags) > 0 {
+		news.Tags = appendUnique(news.Tags, "high-impact")
+	}
+	return nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+var (
+	enrichersMu      sync.Mutex
+	defaultEnrichers = Enrichers{
+		NewSymbolEnricher(DefaultSymbolDictionary()),
+		NewImportanceEnricher(DefaultImportanceKeywords()),
+	}
+)
+
+// RegisterEnricher appends an Enricher (e.g. an LLM-based or external
+// HTTP classifier) to the chain GetNews runs over every item.
+func RegisterEnricher(e Enricher) {
+	enrichersMu.Lock()
+	defer enrichersMu.Unlock()
+	defaultEnrichers = append(defaultEnrichers, e)
+}
+
+func currentEnrichers() Enrichers {
+	enrichersMu.Lock()
+	defer enrichersMu.Unlock()
+	chain := make(Enrichers, len(defaultEnrichers))
+	copy(chain, defaultEnrichers)
+	return chain
+}
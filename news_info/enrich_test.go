@@ -0,0 +1,72 @@
+package news_info
+
+import "testing"
+
+func TestWordBoundaryPatternDoesNotMatchInsideWords(t *testing.T) {
+	re := wordBoundaryPattern("sec")
+	if re.MatchString("Waiting a few seconds for the transaction.") {
+		t.Error(`"sec" should not match inside "seconds"`)
+	}
+	if re.MatchString("prosecuting the exchange") {
+		t.Error(`"sec" should not match inside "prosecuting"`)
+	}
+	if !re.MatchString("The SEC opened an investigation.") {
+		t.Error(`"sec" should match the standalone, case-insensitive word "SEC"`)
+	}
+}
+
+func TestSymbolEnricherMatchesWholeWordsOnly(t *testing.T) {
+	e := NewSymbolEnricher(DefaultSymbolDictionary())
+
+	news := CryptoNews{ContentPrefix: "Market update", Content: "This wallet uses a console for the method call."}
+	if err := e.Enrich(&news); err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if len(news.Symbols) != 0 {
+		t.Errorf("expected no symbols matched in ordinary prose, got %v", news.Symbols)
+	}
+
+	news = CryptoNews{ContentPrefix: "Market update", Content: "ETH broke above $3,000 today."}
+	if err := e.Enrich(&news); err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if len(news.Symbols) != 1 || news.Symbols[0] != "ETH" {
+		t.Errorf("expected [ETH], got %v", news.Symbols)
+	}
+}
+
+func TestImportanceEnricherDoesNotMatchInsideWords(t *testing.T) {
+	e := NewImportanceEnricher(DefaultImportanceKeywords())
+
+	news := CryptoNews{ContentPrefix: "Tutorial", Content: "Please wait a few seconds, then securely connect your wallet."}
+	if err := e.Enrich(&news); err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if len(news.Tags) != 0 {
+		t.Errorf("expected no tags matched in ordinary prose, got %v", news.Tags)
+	}
+}
+
+func TestImportanceEnricherMatchesStandaloneKeyword(t *testing.T) {
+	e := NewImportanceEnricher(DefaultImportanceKeywords())
+
+	news := CryptoNews{ContentPrefix: "Breaking", Content: "The SEC has opened an investigation into the exchange."}
+	if err := e.Enrich(&news); err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if !containsString(news.Tags, "regulatory") {
+		t.Errorf("expected \"regulatory\" tag, got %v", news.Tags)
+	}
+	if !containsString(news.Tags, "high-impact") {
+		t.Errorf("expected \"high-impact\" tag, got %v", news.Tags)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package news_info
+
+import (
+	"fmt"
+	"strings"
+)
+
+// httpStatusError records an HTTP response status from a NewsSource
+// fetch so retry logic can tell client errors (4xx, not worth
+// retrying) apart from transient network/server failures.
+type httpStatusError struct {
+	source     string
+	statusCode int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("%s: http status %d", e.source, e.statusCode)
+}
+
+func (e *httpStatusError) isClientError() bool {
+	return e.statusCode >= 400 && e.statusCode < 500
+}
+
+// MultiError collects the errors from a GetNews fan-out across sources
+// so a single source failing doesn't hide the results from the rest.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	if e == nil || len(e.Errors) == 0 {
+		return ""
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d news source(s) failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (e *MultiError) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+	return e.Errors
+}
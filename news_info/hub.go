@@ -0,0 +1,63 @@
+package news_info
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriberBufferSize is how many undelivered items a subscriber may
+// accumulate before it is considered too slow and dropped.
+const subscriberBufferSize = 32
+
+// Hub fans out published CryptoNews items to any number of
+// subscribers, each with its own buffered channel.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[chan CryptoNews]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[chan CryptoNews]struct{})}
+}
+
+// Subscribe returns a channel of items published to the hub. The
+// channel is closed when ctx is done, or earlier if the subscriber
+// falls behind by more than subscriberBufferSize messages.
+func (h *Hub) Subscribe(ctx context.Context) <-chan CryptoNews {
+	ch := make(chan CryptoNews, subscriberBufferSize)
+
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(ch)
+	}()
+
+	return ch
+}
+
+func (h *Hub) unsubscribe(ch chan CryptoNews) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscribers[ch]; ok {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (h *Hub) publish(news CryptoNews) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- news:
+		default:
+			// Subscriber isn't draining fast enough; drop it rather
+			// than block every other subscriber or the poller.
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
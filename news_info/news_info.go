@@ -1,6 +1,7 @@
 package news_info
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,6 +9,14 @@ import (
 	"time"
 )
 
+// NewsSource is anything that can produce a batch of recent CryptoNews
+// items. Implementations should return at most limit items, ordered
+// newest-first where possible, and populate Poster with their own name.
+type NewsSource interface {
+	Name() string
+	Fetch(ctx context.Context, limit int) ([]CryptoNews, error)
+}
+
 type LiveRecord struct {
 	ID            interface{} `json:"id"`
 	Content       string      `json:"content"`
@@ -25,47 +34,77 @@ type JinseResponse struct {
 	List []LivesList `json:"list"`
 }
 
+// newsTimeLayout is the display format used for CryptoNews.Time.
+// Every NewsSource must format in this layout after converting to
+// UTC, so that chronological ordering across sources can be recovered
+// by parsing the field back with this same layout.
+const newsTimeLayout = "2006-01-02 15:04:05"
+
+// parseNewsTime parses a CryptoNews.Time value produced by a
+// NewsSource back into a time.Time (interpreted as UTC).
+func parseNewsTime(s string) (time.Time, error) {
+	return time.ParseInLocation(newsTimeLayout, s, time.UTC)
+}
+
 type CryptoNews struct {
-	Index         int    `json:"index"`
-	NewID         string `json:"newid"`
-	Content       string `json:"content"`
-	ContentPrefix string `json:"content_prefix"`
-	Link          string `json:"link"`
-	Poster        string `json:"poster"`
-	Time          string `json:"time"`
+	Index         int      `json:"index"`
+	NewID         string   `json:"newid"`
+	Content       string   `json:"content"`
+	ContentPrefix string   `json:"content_prefix"`
+	Link          string   `json:"link"`
+	Poster        string   `json:"poster"`
+	Time          string   `json:"time"`
+	Symbols       []string `json:"symbols,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
 }
 
-func GetNewsJinse(limit int) ([]CryptoNews, error) {
+// jinseSource implements NewsSource against the 金色财经 (Jinse) lives API.
+type jinseSource struct {
+	client *http.Client
+}
+
+// newJinseSource builds a jinseSource with its own *http.Client
+// timeout; pass 0 to use defaultSourceTimeout.
+func newJinseSource(timeout time.Duration) jinseSource {
+	if timeout <= 0 {
+		timeout = defaultSourceTimeout
+	}
+	return jinseSource{client: &http.Client{Timeout: timeout}}
+}
+
+func (jinseSource) Name() string { return "金色财经" }
+
+func (s jinseSource) Fetch(ctx context.Context, limit int) ([]CryptoNews, error) {
 	if limit <= 0 {
 		limit = 20
 	}
 	url := "http://api.jinse.cn/noah/v2/lives?limit=20&reading=false&source=web&flag=up&id=353150&category=0"
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/88.0.4324.96 Safari/537.36")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
 
-	resp, err := client.Do(req)
+	resp, err := s.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= 400 {
+		return nil, &httpStatusError{source: s.Name(), statusCode: resp.StatusCode}
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// fmt.Println(string(body))
-
 	var result JinseResponse
 	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, err
 	}
-	// fmt.Println(result)
 
 	cryptoNews := []CryptoNews{}
 	i := 0
@@ -81,13 +120,8 @@ func GetNewsJinse(limit int) ([]CryptoNews, error) {
 				Content:       record.Content,
 				ContentPrefix: record.ContentPrefix,
 				Link:          record.Link,
-				Poster:        "金色财经",
-				Time:          time.Unix(record.CreatedAt, 0).Format("2006-01-02 15:04:05"),
-			}
-			// fmt.Println("12", string(record.CreatedAt))
-			createdAtTime := time.Unix(record.CreatedAt, 0)
-			if time.Since(createdAtTime) > 30*time.Minute {
-				continue
+				Poster:        s.Name(),
+				Time:          time.Unix(record.CreatedAt, 0).UTC().Format(newsTimeLayout),
 			}
 			cryptoNews = append(cryptoNews, news)
 			i++
@@ -96,3 +130,11 @@ func GetNewsJinse(limit int) ([]CryptoNews, error) {
 
 	return cryptoNews, nil
 }
+
+// GetNewsJinse fetches the latest crypto news from 金色财经. It is kept
+// as a thin wrapper around jinseSource for backwards compatibility with
+// existing callers; new code should prefer GetNews, which also pulls in
+// any feeds registered via RegisterFeed.
+func GetNewsJinse(limit int) ([]CryptoNews, error) {
+	return newJinseSource(defaultSourceTimeout).Fetch(context.Background(), limit)
+}
@@ -0,0 +1,95 @@
+package news_info
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultPollInterval = 1 * time.Minute
+
+var defaultHub = NewHub()
+
+// Subscribe returns a channel of newly published CryptoNews items,
+// fed by the background poller started with StartPolling. Items are
+// already enriched; pass a Filter option to additionally restrict
+// this subscriber to a subset, e.g. Subscribe(ctx, Filter(isBTC)).
+// The channel is closed when ctx is done or the subscriber falls too
+// far behind.
+func Subscribe(ctx context.Context, opts ...Option) <-chan CryptoNews {
+	raw := defaultHub.Subscribe(ctx)
+
+	var options newsOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.filter == nil {
+		return raw
+	}
+
+	filtered := make(chan CryptoNews, subscriberBufferSize)
+	go func() {
+		defer close(filtered)
+		for item := range raw {
+			if !options.filter(item) {
+				continue
+			}
+			select {
+			case filtered <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return filtered
+}
+
+// StartPolling launches one background goroutine per registered
+// NewsSource, each polling at interval, deduping against the
+// configured SeenStore, and publishing newly-seen items to every
+// Subscribe-r. It returns immediately; polling stops when ctx is done.
+func StartPolling(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	sourcesMu.Lock()
+	snapshot := make([]NewsSource, len(sources))
+	copy(snapshot, sources)
+	sourcesMu.Unlock()
+
+	for _, src := range snapshot {
+		go pollSource(ctx, src, interval)
+	}
+}
+
+func pollSource(ctx context.Context, src NewsSource, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			news, err := fetchWithRetry(ctx, src, 20)
+			if err != nil {
+				continue
+			}
+
+			store := currentSeenStore()
+			enrichers := currentEnrichers()
+			now := time.Now()
+			for _, item := range news {
+				if store.Seen(item.Poster, item.NewID) {
+					continue
+				}
+				enrichers.Enrich(&item)
+				if err := store.MarkSeen(item.Poster, item.NewID, now); err != nil {
+					log.Printf("news_info: failed to mark %s/%s as seen: %v", item.Poster, item.NewID, err)
+				}
+				defaultHub.publish(item)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package news_info
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeXMLStripsIllegalControlChars(t *testing.T) {
+	input := "<title>Hello\x00World\x01\x02</title>"
+	got := sanitizeXML(input)
+	if strings.ContainsAny(got, "\x00\x01\x02") {
+		t.Fatalf("sanitizeXML left illegal control characters: %q", got)
+	}
+	if !strings.Contains(got, "HelloWorld") {
+		t.Fatalf("sanitizeXML should only drop illegal bytes, not legal content: %q", got)
+	}
+}
+
+func TestSanitizeXMLPreservesValidContent(t *testing.T) {
+	input := "<title>BTC hits $100k\nnew high</title>"
+	if got := sanitizeXML(input); got != input {
+		t.Fatalf("sanitizeXML altered valid XML content: got %q, want %q", got, input)
+	}
+}
+
+func TestRSSSourceFallsBackToLinkWhenGUIDMissing(t *testing.T) {
+	const feedXML = `<?xml version="1.0"?>
+<rss version="2.0">
+<channel>
+<title>Test Feed</title>
+<item>
+<title>No GUID here</title>
+<link>https://example.com/no-guid</link>
+<description>body</description>
+</item>
+</channel>
+</rss>`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(feedXML))
+	}))
+	defer srv.Close()
+
+	src := rssSource{name: "test-feed", url: srv.URL, client: srv.Client()}
+	news, err := src.Fetch(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if len(news) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(news))
+	}
+	if news[0].NewID != "https://example.com/no-guid" {
+		t.Errorf("NewID = %q, want fallback to item.Link", news[0].NewID)
+	}
+}
+
+func TestRSSSourceUsesGUIDWhenPresent(t *testing.T) {
+	const feedXML = `<?xml version="1.0"?>
+<rss version="2.0">
+<channel>
+<title>Test Feed</title>
+<item>
+<title>Has GUID</title>
+<link>https://example.com/has-guid</link>
+<guid>unique-guid-123</guid>
+<description>body</description>
+</item>
+</channel>
+</rss>`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(feedXML))
+	}))
+	defer srv.Close()
+
+	src := rssSource{name: "test-feed", url: srv.URL, client: srv.Client()}
+	news, err := src.Fetch(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if len(news) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(news))
+	}
+	if news[0].NewID != "unique-guid-123" {
+		t.Errorf("NewID = %q, want the feed's own guid", news[0].NewID)
+	}
+}
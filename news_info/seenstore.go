@@ -0,0 +1,76 @@
+package news_info
+
+import (
+	"sync"
+	"time"
+)
+
+// SeenStore tracks which news items have already been emitted, keyed
+// by Poster + NewID, so GetNews only returns genuinely new items and a
+// re-poll doesn't re-emit everything in the current time window.
+// Implementations must be safe for concurrent use.
+type SeenStore interface {
+	// Seen reports whether the item has already been recorded.
+	Seen(poster, newID string) bool
+	// MarkSeen records that the item was emitted at seenAt.
+	MarkSeen(poster, newID string, seenAt time.Time) error
+	// Prune removes records older than olderThan.
+	Prune(olderThan time.Duration) error
+	// LastSeenAt returns the most recent seenAt recorded for source,
+	// or the zero time if nothing has been recorded for it yet.
+	LastSeenAt(source string) time.Time
+}
+
+func seenKey(poster, newID string) string {
+	return poster + "|" + newID
+}
+
+// MemorySeenStore is an in-process SeenStore. It does not persist
+// across restarts; use NewBoltSeenStore or NewSQLiteSeenStore for that.
+type MemorySeenStore struct {
+	mu       sync.RWMutex
+	seenAt   map[string]time.Time
+	lastSeen map[string]time.Time
+}
+
+func NewMemorySeenStore() *MemorySeenStore {
+	return &MemorySeenStore{
+		seenAt:   make(map[string]time.Time),
+		lastSeen: make(map[string]time.Time),
+	}
+}
+
+func (m *MemorySeenStore) Seen(poster, newID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.seenAt[seenKey(poster, newID)]
+	return ok
+}
+
+func (m *MemorySeenStore) MarkSeen(poster, newID string, seenAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seenAt[seenKey(poster, newID)] = seenAt
+	if seenAt.After(m.lastSeen[poster]) {
+		m.lastSeen[poster] = seenAt
+	}
+	return nil
+}
+
+func (m *MemorySeenStore) Prune(olderThan time.Duration) error {
+	cutoff := time.Now().Add(-olderThan)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, t := range m.seenAt {
+		if t.Before(cutoff) {
+			delete(m.seenAt, key)
+		}
+	}
+	return nil
+}
+
+func (m *MemorySeenStore) LastSeenAt(source string) time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastSeen[source]
+}
@@ -0,0 +1,106 @@
+package news_info
+
+import (
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	boltSeenBucket     = []byte("seen_items")
+	boltLastSeenBucket = []byte("last_seen")
+)
+
+// BoltSeenStore is a SeenStore backed by a BoltDB file, so seen items
+// survive process restarts.
+type BoltSeenStore struct {
+	db *bolt.DB
+}
+
+// NewBoltSeenStore opens (creating if necessary) a BoltDB file at path
+// to use as a SeenStore.
+func NewBoltSeenStore(path string) (*BoltSeenStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: defaultSourceTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(boltSeenBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(boltLastSeenBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltSeenStore{db: db}, nil
+}
+
+func (b *BoltSeenStore) Close() error {
+	return b.db.Close()
+}
+
+func (b *BoltSeenStore) Seen(poster, newID string) bool {
+	key := seenKey(poster, newID)
+	var found bool
+	b.db.View(func(tx *bolt.Tx) error {
+		found = tx.Bucket(boltSeenBucket).Get([]byte(key)) != nil
+		return nil
+	})
+	return found
+}
+
+func (b *BoltSeenStore) MarkSeen(poster, newID string, seenAt time.Time) error {
+	key := seenKey(poster, newID)
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(boltSeenBucket).Put([]byte(key), []byte(seenAt.Format(time.RFC3339Nano))); err != nil {
+			return err
+		}
+
+		lastSeen := tx.Bucket(boltLastSeenBucket)
+		current := lastSeen.Get([]byte(poster))
+		if current != nil {
+			if t, err := time.Parse(time.RFC3339Nano, string(current)); err == nil && !seenAt.After(t) {
+				return nil
+			}
+		}
+		return lastSeen.Put([]byte(poster), []byte(seenAt.Format(time.RFC3339Nano)))
+	})
+}
+
+func (b *BoltSeenStore) Prune(olderThan time.Duration) error {
+	cutoff := time.Now().Add(-olderThan)
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltSeenBucket)
+		c := bucket.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			t, err := time.Parse(time.RFC3339Nano, string(v))
+			if err != nil || t.Before(cutoff) {
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (b *BoltSeenStore) LastSeenAt(source string) time.Time {
+	var last time.Time
+	b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(boltLastSeenBucket).Get([]byte(source))
+		if v == nil {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, string(v))
+		if err == nil {
+			last = t
+		}
+		return nil
+	})
+	return last
+}
@@ -0,0 +1,73 @@
+package news_info
+
+import (
+	"database/sql"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteSeenStore is a SeenStore backed by a SQLite database file, so
+// seen items survive process restarts.
+type SQLiteSeenStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteSeenStore opens (creating if necessary) a SQLite database at
+// path to use as a SeenStore.
+func NewSQLiteSeenStore(path string) (*SQLiteSeenStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	const schema = `
+CREATE TABLE IF NOT EXISTS seen_items (
+	poster  TEXT NOT NULL,
+	new_id  TEXT NOT NULL,
+	seen_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (poster, new_id)
+);
+CREATE INDEX IF NOT EXISTS idx_seen_items_poster ON seen_items (poster, seen_at);
+`
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteSeenStore{db: db}, nil
+}
+
+func (s *SQLiteSeenStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLiteSeenStore) Seen(poster, newID string) bool {
+	var exists int
+	err := s.db.QueryRow(`SELECT 1 FROM seen_items WHERE poster = ? AND new_id = ?`, poster, newID).Scan(&exists)
+	return err == nil
+}
+
+func (s *SQLiteSeenStore) MarkSeen(poster, newID string, seenAt time.Time) error {
+	_, err := s.db.Exec(
+		`INSERT INTO seen_items (poster, new_id, seen_at) VALUES (?, ?, ?)
+		 ON CONFLICT (poster, new_id) DO UPDATE SET seen_at = excluded.seen_at`,
+		poster, newID, seenAt,
+	)
+	return err
+}
+
+func (s *SQLiteSeenStore) Prune(olderThan time.Duration) error {
+	cutoff := time.Now().Add(-olderThan)
+	_, err := s.db.Exec(`DELETE FROM seen_items WHERE seen_at < ?`, cutoff)
+	return err
+}
+
+func (s *SQLiteSeenStore) LastSeenAt(source string) time.Time {
+	var seenAt time.Time
+	err := s.db.QueryRow(`SELECT seen_at FROM seen_items WHERE poster = ? ORDER BY seen_at DESC LIMIT 1`, source).Scan(&seenAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return seenAt
+}
@@ -0,0 +1,78 @@
+package news_info
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSeenKeyDistinguishesPosterAndNewID(t *testing.T) {
+	if seenKey("a", "1") == seenKey("b", "1") {
+		t.Error("items with the same NewID from different posters must not collide")
+	}
+	if seenKey("a", "1") == seenKey("a", "2") {
+		t.Error("items with different NewIDs from the same poster must not collide")
+	}
+	if seenKey("a", "1") != seenKey("a", "1") {
+		t.Error("the same poster/NewID pair must produce the same key")
+	}
+}
+
+func TestMemorySeenStoreSeenAndMarkSeen(t *testing.T) {
+	store := NewMemorySeenStore()
+
+	if store.Seen("jinse", "1") {
+		t.Fatal("item should not be seen before MarkSeen")
+	}
+
+	if err := store.MarkSeen("jinse", "1", time.Now()); err != nil {
+		t.Fatalf("MarkSeen: %v", err)
+	}
+	if !store.Seen("jinse", "1") {
+		t.Error("item should be seen after MarkSeen")
+	}
+	if store.Seen("jinse", "2") {
+		t.Error("a different NewID from the same poster must not be marked seen")
+	}
+	if store.Seen("other-source", "1") {
+		t.Error("the same NewID from a different poster must not be marked seen")
+	}
+}
+
+func TestMemorySeenStorePrune(t *testing.T) {
+	store := NewMemorySeenStore()
+	now := time.Now()
+
+	store.MarkSeen("jinse", "old", now.Add(-time.Hour))
+	store.MarkSeen("jinse", "new", now)
+
+	if err := store.Prune(30 * time.Minute); err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+
+	if store.Seen("jinse", "old") {
+		t.Error("item older than the prune window should have been removed")
+	}
+	if !store.Seen("jinse", "new") {
+		t.Error("item newer than the prune window should remain")
+	}
+}
+
+func TestMemorySeenStoreLastSeenAt(t *testing.T) {
+	store := NewMemorySeenStore()
+
+	if !store.LastSeenAt("jinse").IsZero() {
+		t.Fatal("LastSeenAt should be zero before anything is marked seen")
+	}
+
+	first := time.Now().Add(-time.Minute)
+	second := time.Now()
+	store.MarkSeen("jinse", "1", first)
+	store.MarkSeen("jinse", "2", second)
+
+	if got := store.LastSeenAt("jinse"); !got.Equal(second) {
+		t.Errorf("LastSeenAt(jinse) = %v, want %v (the most recent mark)", got, second)
+	}
+	if !store.LastSeenAt("other-source").IsZero() {
+		t.Error("LastSeenAt for a source with no marks should be zero")
+	}
+}
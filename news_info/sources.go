@@ -0,0 +1,227 @@
+package news_info
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultSourceTimeout bounds a single HTTP round trip to a source.
+	defaultSourceTimeout = 10 * time.Second
+
+	// maxFetchRetries bounds how many times a transient failure is
+	// retried; 4xx responses abort immediately since retrying them
+	// can't help.
+	maxFetchRetries    = 3
+	maxConcurrentFetch = 8
+)
+
+// retryBaseInterval is the first backoff wait in fetchWithRetry,
+// doubling on each subsequent attempt. It is a var rather than a
+// const so tests can shrink it instead of sleeping for real.
+var retryBaseInterval = 10 * time.Second
+
+var (
+	sourcesMu sync.Mutex
+	sources   = []NewsSource{newJinseSource(defaultSourceTimeout)}
+
+	seenStoreMu sync.RWMutex
+	seenStore   SeenStore = NewMemorySeenStore()
+)
+
+// registerSource adds a NewsSource to the set consulted by GetNews.
+func registerSource(s NewsSource) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	sources = append(sources, s)
+}
+
+// SetJinseTimeout reconfigures the registered Jinse source's HTTP
+// client timeout; pass 0 to use defaultSourceTimeout.
+func SetJinseTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultSourceTimeout
+	}
+
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	for _, src := range sources {
+		if js, ok := src.(jinseSource); ok {
+			js.client.Timeout = timeout
+		}
+	}
+}
+
+// SetSeenStore replaces the SeenStore used to dedupe GetNews results,
+// e.g. with a BoltSeenStore or SQLiteSeenStore so seen items persist
+// across restarts. The default is an in-memory store.
+func SetSeenStore(store SeenStore) {
+	seenStoreMu.Lock()
+	defer seenStoreMu.Unlock()
+	seenStore = store
+}
+
+func currentSeenStore() SeenStore {
+	seenStoreMu.RLock()
+	defer seenStoreMu.RUnlock()
+	return seenStore
+}
+
+// backoffInterval returns the wait before retry attempt (0-indexed),
+// doubling from retryBaseInterval on each successive attempt.
+func backoffInterval(attempt int) time.Duration {
+	return retryBaseInterval * time.Duration(uint(1)<<uint(attempt))
+}
+
+// fetchWithRetry calls src.Fetch, retrying transient failures up to
+// maxFetchRetries times with exponential backoff (base 10s, doubling
+// each attempt). A 4xx response is not retried.
+func fetchWithRetry(ctx context.Context, src NewsSource, limit int) ([]CryptoNews, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxFetchRetries; attempt++ {
+		news, err := src.Fetch(ctx, limit)
+		if err == nil {
+			return news, nil
+		}
+		lastErr = err
+
+		var statusErr *httpStatusError
+		if errors.As(err, &statusErr) && statusErr.isClientError() {
+			return nil, err
+		}
+		if attempt == maxFetchRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoffInterval(attempt)):
+		}
+	}
+
+	return nil, lastErr
+}
+
+// Option configures a GetNews call. See Filter.
+type Option func(*newsOptions)
+
+type newsOptions struct {
+	filter func(CryptoNews) bool
+}
+
+// Filter restricts GetNews to items for which keep returns true, e.g.
+// only BTC-related items or only items tagged "high-impact". Filtering
+// runs after enrichment, so it can inspect Symbols and Tags.
+//
+// Seen-tracking only covers items GetNews actually returns: an item
+// that fails the filter is never marked seen, so it stays in the
+// source's current window and will be re-fetched, re-enriched, and
+// re-filtered on every subsequent call until it ages out of the
+// source itself. This is intentional — a filtered-out item was never
+// "emitted" to this caller — but it means a Filter that rarely
+// matches does not reduce per-call fetch/enrich work.
+func Filter(keep func(CryptoNews) bool) Option {
+	return func(o *newsOptions) { o.filter = keep }
+}
+
+// GetNews fetches the latest news from every registered NewsSource
+// (the built-in Jinse source plus any feeds added via RegisterFeed)
+// concurrently, merges the results newest-first and caps them at
+// limit. Sources that fail (after retries) are skipped; their errors
+// are returned together as a *MultiError alongside whatever other
+// sources did produce, rather than discarding everything.
+func GetNews(limit int, opts ...Option) ([]CryptoNews, error) {
+	return GetNewsContext(context.Background(), limit, opts...)
+}
+
+// GetNewsContext is GetNews with an explicit context for cancellation.
+func GetNewsContext(ctx context.Context, limit int, opts ...Option) ([]CryptoNews, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var options newsOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	sourcesMu.Lock()
+	snapshot := make([]NewsSource, len(sources))
+	copy(snapshot, sources)
+	sourcesMu.Unlock()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		sem      = make(chan struct{}, maxConcurrentFetch)
+		merged   = []CryptoNews{}
+		multiErr = &MultiError{}
+	)
+
+	for _, src := range snapshot {
+		src := src
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			news, err := fetchWithRetry(ctx, src, limit)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				multiErr.Errors = append(multiErr.Errors, err)
+				return
+			}
+			merged = append(merged, news...)
+		}()
+	}
+	wg.Wait()
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		ti, erri := parseNewsTime(merged[i].Time)
+		tj, errj := parseNewsTime(merged[j].Time)
+		if erri != nil || errj != nil {
+			return merged[i].Time > merged[j].Time
+		}
+		return ti.After(tj)
+	})
+
+	store := currentSeenStore()
+	enrichers := currentEnrichers()
+	fresh := make([]CryptoNews, 0, len(merged))
+	now := time.Now()
+	for _, item := range merged {
+		if store.Seen(item.Poster, item.NewID) {
+			continue
+		}
+		enrichers.Enrich(&item)
+		if options.filter != nil && !options.filter(item) {
+			continue
+		}
+		fresh = append(fresh, item)
+		if len(fresh) >= limit {
+			break
+		}
+	}
+	for _, item := range fresh {
+		if err := store.MarkSeen(item.Poster, item.NewID, now); err != nil {
+			log.Printf("news_info: failed to mark %s/%s as seen: %v", item.Poster, item.NewID, err)
+		}
+	}
+	for i := range fresh {
+		fresh[i].Index = i
+	}
+
+	if len(multiErr.Errors) > 0 {
+		return fresh, multiErr
+	}
+	return fresh, nil
+}
@@ -0,0 +1,113 @@
+package news_info
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoffIntervalDoublesFromBase(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, retryBaseInterval},
+		{1, 2 * retryBaseInterval},
+		{2, 4 * retryBaseInterval},
+		{3, 8 * retryBaseInterval},
+	}
+
+	for _, tc := range cases {
+		if got := backoffInterval(tc.attempt); got != tc.want {
+			t.Errorf("backoffInterval(%d) = %v, want %v", tc.attempt, got, tc.want)
+		}
+	}
+}
+
+type stubSource struct {
+	name    string
+	results []struct {
+		news []CryptoNews
+		err  error
+	}
+	calls int
+}
+
+func (s *stubSource) Name() string { return s.name }
+
+func (s *stubSource) Fetch(ctx context.Context, limit int) ([]CryptoNews, error) {
+	r := s.results[s.calls]
+	s.calls++
+	return r.news, r.err
+}
+
+func TestFetchWithRetryAbortsOn4xxWithoutRetrying(t *testing.T) {
+	src := &stubSource{name: "stub"}
+	src.results = append(src.results, struct {
+		news []CryptoNews
+		err  error
+	}{nil, &httpStatusError{source: "stub", statusCode: 404}})
+	src.results = append(src.results, struct {
+		news []CryptoNews
+		err  error
+	}{[]CryptoNews{{Poster: "stub"}}, nil})
+
+	_, err := fetchWithRetry(context.Background(), src, 10)
+	if err == nil {
+		t.Fatal("expected error for 4xx response, got nil")
+	}
+	var statusErr *httpStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected httpStatusError, got %T: %v", err, err)
+	}
+	if src.calls != 1 {
+		t.Errorf("expected exactly 1 call (no retry on 4xx), got %d", src.calls)
+	}
+}
+
+func TestFetchWithRetryStopsWhenContextCancelled(t *testing.T) {
+	src := &stubSource{name: "stub"}
+	src.results = append(src.results, struct {
+		news []CryptoNews
+		err  error
+	}{nil, errors.New("transient")})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := fetchWithRetry(ctx, src, 10)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if src.calls != 1 {
+		t.Errorf("expected exactly 1 call before the backoff wait was cancelled, got %d", src.calls)
+	}
+}
+
+func TestFetchWithRetrySucceedsAfterTransientFailure(t *testing.T) {
+	original := retryBaseInterval
+	retryBaseInterval = time.Millisecond
+	defer func() { retryBaseInterval = original }()
+
+	src := &stubSource{name: "stub"}
+	src.results = append(src.results, struct {
+		news []CryptoNews
+		err  error
+	}{nil, errors.New("transient")})
+	src.results = append(src.results, struct {
+		news []CryptoNews
+		err  error
+	}{[]CryptoNews{{Poster: "stub", NewID: "1"}}, nil})
+
+	news, err := fetchWithRetry(context.Background(), src, 10)
+	if err != nil {
+		t.Fatalf("expected success after one retry, got error: %v", err)
+	}
+	if len(news) != 1 || news[0].NewID != "1" {
+		t.Fatalf("unexpected news returned: %+v", news)
+	}
+	if src.calls != 2 {
+		t.Errorf("expected 2 calls (1 failure + 1 retry), got %d", src.calls)
+	}
+}
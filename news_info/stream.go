@@ -0,0 +1,125 @@
+package news_info
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// NewsStreamHandler serves newly published CryptoNews items as
+// Server-Sent Events. Mount it at e.g. "/news/stream".
+func NewsStreamHandler(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ctx := r.Context()
+	news := Subscribe(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case item, ok := <-news:
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(item)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flusher.Flush()
+		}
+	}
+}
+
+var (
+	allowedOriginsMu sync.RWMutex
+	allowedOrigins   = map[string]struct{}{}
+)
+
+// SetAllowedOrigins configures the extra browser Origins that
+// NewsWebSocketHandler accepts, beyond the request's own Host (same-
+// origin is always allowed). Call this before serving if the news
+// stream dashboard is hosted on a different origin than this handler.
+func SetAllowedOrigins(origins ...string) {
+	allowedOriginsMu.Lock()
+	defer allowedOriginsMu.Unlock()
+	allowedOrigins = make(map[string]struct{}, len(origins))
+	for _, o := range origins {
+		allowedOrigins[o] = struct{}{}
+	}
+}
+
+// checkOrigin guards against cross-site WebSocket hijacking: non-
+// browser clients (no Origin header) are allowed, same-origin requests
+// are allowed, and anything else must be on the SetAllowedOrigins list.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if origin == "http://"+r.Host || origin == "https://"+r.Host {
+		return true
+	}
+
+	allowedOriginsMu.RLock()
+	defer allowedOriginsMu.RUnlock()
+	_, ok := allowedOrigins[origin]
+	return ok
+}
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     checkOrigin,
+}
+
+// NewsWebSocketHandler upgrades the connection to a WebSocket and
+// pushes newly published CryptoNews items as JSON text frames. Mount
+// it at e.g. "/news/ws".
+func NewsWebSocketHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	news := Subscribe(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case item, ok := <-news:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(item); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package news_info
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckOriginAllowsNoOriginHeader(t *testing.T) {
+	r := httptest.NewRequest("GET", "/news/ws", nil)
+	if !checkOrigin(r) {
+		t.Error("requests with no Origin header (non-browser clients) should be allowed")
+	}
+}
+
+func TestCheckOriginAllowsSameOrigin(t *testing.T) {
+	r := httptest.NewRequest("GET", "/news/ws", nil)
+	r.Host = "example.com"
+	r.Header.Set("Origin", "https://example.com")
+	if !checkOrigin(r) {
+		t.Error("a same-origin request should be allowed")
+	}
+}
+
+func TestCheckOriginRejectsDisallowedCrossOrigin(t *testing.T) {
+	SetAllowedOrigins()
+	defer SetAllowedOrigins()
+
+	r := httptest.NewRequest("GET", "/news/ws", nil)
+	r.Host = "example.com"
+	r.Header.Set("Origin", "https://evil.example")
+	if checkOrigin(r) {
+		t.Error("a cross-origin request not on the allow-list must be rejected")
+	}
+}
+
+func TestCheckOriginAllowsConfiguredOrigin(t *testing.T) {
+	SetAllowedOrigins("https://dashboard.example")
+	defer SetAllowedOrigins()
+
+	r := httptest.NewRequest("GET", "/news/ws", nil)
+	r.Host = "example.com"
+	r.Header.Set("Origin", "https://dashboard.example")
+	if !checkOrigin(r) {
+		t.Error("an origin added via SetAllowedOrigins should be allowed")
+	}
+}